@@ -1,15 +1,16 @@
 package app
 
 import (
-	"bytes"
 	"context"
 	_ "embed"
-	"encoding/json"
 	"fmt"
 	"log"
-	"net/http"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"fyne.io/fyne/v2"
@@ -18,53 +19,74 @@ import (
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
 	"github.com/atotto/clipboard"
+
+	"github.com/luckygeck/lai/backend"
+	"github.com/luckygeck/lai/history"
+	"github.com/luckygeck/lai/locale"
+	"github.com/luckygeck/lai/queue"
+	"github.com/luckygeck/lai/templates"
 )
 
 //go:embed lai-60x60@3x.png
 var iconData []byte
 
+const defaultModel = "gemma3n:e4b"
+
+const prefKeyLanguage = "locale.lang"
+
 const (
-	ollamaURL       = "http://localhost:11434/api/generate"
-	ollamaModelsURL = "http://localhost:11434/api/tags"
-	defaultModel    = "gemma3n:e4b"
+	prefKeyTemplates      = "templates.json"
+	prefKeyActiveTemplate = "templates.active"
 )
 
+// numberedKeys lists the digit keys used as template hotkeys, in order.
+var numberedKeys = []fyne.KeyName{
+	fyne.Key1, fyne.Key2, fyne.Key3, fyne.Key4, fyne.Key5,
+	fyne.Key6, fyne.Key7, fyne.Key8, fyne.Key9,
+}
+
+// queueConcurrency is how many translation jobs run at once. Kept low since
+// it's mostly there to stop a queued-up batch from serializing behind a
+// single slow request, not to parallelize heavily.
+const queueConcurrency = 2
+
+// providers lists the backend names the Settings dialog offers, in the
+// order they're displayed and merged into the model dropdown.
+var providers = []string{"ollama", "openai", "anthropic", "google"}
+
 type App struct {
-	modelName     string
+	selectionMu   sync.RWMutex
+	modelName     string // guarded by selectionMu; see selectedModel/setSelectedModel
+	activeBackend string // provider name the current modelName belongs to; guarded by selectionMu
+
 	app           fyne.App
 	window        fyne.Window
 	modelDropdown *widget.Select
 
-	input  binding.String
-	result binding.String
-	status binding.String
-}
+	templateList     []templates.Template
+	activeTemplate   string // name of the template in templateList currently selected
+	templateDropdown *widget.Select
 
-type OllamaRequest struct {
-	Model  string `json:"model"`
-	Prompt string `json:"prompt"`
-	Stream bool   `json:"stream"`
-}
+	backendsMu sync.RWMutex
+	backends   map[string]backend.Backend // guarded by backendsMu; see setBackends/getBackend/backendNames
 
-type OllamaResponse struct {
-	Response string `json:"response"`
-	Done     bool   `json:"done"`
-}
+	history *history.Store
+	queue   *queue.Queue
+	loc     *locale.Catalog
 
-type OllamaModel struct {
-	Name       string    `json:"name"`
-	ModifiedAt time.Time `json:"modified_at"`
-	Size       int64     `json:"size"`
-}
+	clipboardWatchStop chan struct{}
+	lastSeenClipboard  string
 
-type OllamaModelsResponse struct {
-	Models []OllamaModel `json:"models"`
+	input  binding.String
+	result binding.String
+	status binding.String
 }
 
 func New(app fyne.App) *App {
 	return &App{
-		app:       app,
-		modelName: defaultModel,
+		app:           app,
+		modelName:     defaultModel,
+		activeBackend: "ollama",
 	}
 }
 
@@ -81,6 +103,12 @@ func (a *App) setupApp() {
 	iconResource := fyne.NewStaticResource("lai-60x60@3x.png", iconData)
 	a.app.SetIcon(iconResource)
 
+	a.loadBackends()
+	a.openHistory()
+	a.queue = queue.New(a.runQueuedTranslation, queueConcurrency)
+	a.loadLocale()
+	a.loadTemplates()
+
 	// Create a window that will be hidden by default
 	a.window = a.app.NewWindow("lai")
 	a.window.Resize(fyne.NewSize(600, 500))
@@ -91,46 +119,142 @@ func (a *App) setupApp() {
 	// Set up keyboard shortcuts
 	a.setupKeyboardShortcuts()
 
-	// Create UI elements
 	a.status = binding.NewString()
-	a.status.Set("Click 'Translate' to start translating")
+	a.input = binding.NewString()
+	a.result = binding.NewString()
+
+	a.renderMainWindow()
+	a.window.Show()
+
+	// Load available models on startup
+	a.refreshModelDropdown()
+
+	a.refreshTrayMenu()
+
+	if a.app.Preferences().Bool(prefKeyClipboardWatchEnabled) {
+		a.startClipboardWatch()
+	}
+}
+
+// loadLocale loads the UI message catalogs and activates the language saved
+// in preferences, falling back to locale.DefaultLanguage.
+func (a *App) loadLocale() {
+	lang := a.app.Preferences().StringWithFallback(prefKeyLanguage, locale.DefaultLanguage)
+	loc, err := locale.New(lang)
+	if err != nil {
+		log.Printf("failed to load locale %q: %v", lang, err)
+		loc, _ = locale.New(locale.DefaultLanguage)
+	}
+	a.loc = loc
+}
+
+// loadTemplates loads the persisted template list (or templates.Defaults if
+// nothing has been saved yet) and restores the previously active template.
+func (a *App) loadTemplates() {
+	list, err := templates.Unmarshal(a.app.Preferences().String(prefKeyTemplates))
+	if err != nil {
+		log.Printf("failed to load templates, falling back to defaults: %v", err)
+		list = templates.Defaults
+	}
+	a.templateList = list
+
+	active := a.app.Preferences().String(prefKeyActiveTemplate)
+	a.activeTemplate = ""
+	for _, t := range list {
+		if t.Name == active {
+			a.activeTemplate = active
+			break
+		}
+	}
+	if a.activeTemplate == "" && len(list) > 0 {
+		a.activeTemplate = list[0].Name
+	}
+}
+
+// currentPromptTemplate returns the Prompt of the active template, falling
+// back to the first shipped default if the active template was deleted out
+// from under it.
+func (a *App) currentPromptTemplate() string {
+	for _, t := range a.templateList {
+		if t.Name == a.activeTemplate {
+			return t.Prompt
+		}
+	}
+	return templates.Defaults[0].Prompt
+}
+
+// refreshTemplateDropdown updates the template dropdown's options after the
+// list changes in Settings, without rebuilding the whole window.
+func (a *App) refreshTemplateDropdown() {
+	if a.templateDropdown == nil {
+		return
+	}
+	names := make([]string, len(a.templateList))
+	for i, t := range a.templateList {
+		names[i] = t.Name
+	}
+	a.templateDropdown.Options = names
+	a.templateDropdown.SetSelected(a.activeTemplate)
+	a.templateDropdown.Refresh()
+}
+
+// renderMainWindow (re)builds the main window's content using the active
+// locale. It's called on startup and again after the user changes language,
+// rebuilding every widget but reusing the existing input/result/status
+// bindings so the input and result values survive the switch; the status
+// line is reset to the initial message.
+func (a *App) renderMainWindow() {
+	a.status.Set(a.loc.T("status_initial"))
 	statusText := widget.NewLabelWithData(a.status)
 	statusText.Wrapping = fyne.TextWrapWord
 
-	// Create model dropdown
-	a.modelDropdown = widget.NewSelect([]string{a.modelName}, func(selected string) {
+	a.modelDropdown = widget.NewSelect([]string{a.qualifiedModelName()}, func(selected string) {
 		if selected != "" {
-			a.modelName = selected
-			a.setStatus("Model changed to: %s", selected)
+			a.setSelectedModel(selected)
+			a.setStatus(a.loc.T("status_model_changed", selected))
 		}
 	})
-	a.modelDropdown.SetSelected(a.modelName)
-	a.modelDropdown.PlaceHolder = "Select model..."
+	a.modelDropdown.SetSelected(a.qualifiedModelName())
+	a.modelDropdown.PlaceHolder = a.loc.T("placeholder_select_model")
+
+	templateNames := make([]string, len(a.templateList))
+	for i, t := range a.templateList {
+		templateNames[i] = t.Name
+	}
+	a.templateDropdown = widget.NewSelect(templateNames, func(selected string) {
+		if selected == "" {
+			return
+		}
+		a.selectTemplate(selected)
+	})
+	a.templateDropdown.SetSelected(a.activeTemplate)
+	a.templateDropdown.PlaceHolder = a.loc.T("placeholder_select_template")
 
-	// Model selection container
 	modelContainer := container.NewHBox(
-		widget.NewLabel("Model:"),
+		widget.NewLabel(a.loc.T("label_model")),
 		a.modelDropdown,
-		widget.NewButton("Refresh", func() { a.refreshModelDropdown() }),
+		widget.NewButton(a.loc.T("button_refresh"), func() { a.refreshModelDropdown() }),
+		widget.NewLabel(a.loc.T("label_template")),
+		a.templateDropdown,
 	)
 
-	a.input = binding.NewString()
 	inputText := widget.NewEntryWithData(a.input)
-	inputText.SetPlaceHolder("Enter text to translate...")
+	inputText.SetPlaceHolder(a.loc.T("placeholder_input"))
 	inputText.MultiLine = true
 	inputText.Wrapping = fyne.TextWrapWord
 
-	a.result = binding.NewString()
 	resultText := widget.NewEntryWithData(a.result)
-	resultText.SetPlaceHolder("Translation will appear here...")
+	resultText.SetPlaceHolder(a.loc.T("placeholder_result"))
 	resultText.MultiLine = true
 	resultText.Wrapping = fyne.TextWrapWord
 
 	buttonContainer := container.NewHBox(
-		widget.NewButton("Translate Clipboard", func() { a.translateClipboardText() }),
-		widget.NewButton("Translate", func() { a.translateInputText() }),
-		widget.NewButton("Settings", func() { a.showSettings() }),
-		widget.NewButton("Hide", func() { a.window.Hide() }),
+		widget.NewButton(a.loc.T("button_translate_clipboard"), func() { a.translateClipboardText() }),
+		widget.NewButton(a.loc.T("button_translate"), func() { a.translateInputText() }),
+		widget.NewButton(a.loc.T("button_history"), func() { a.showHistory() }),
+		widget.NewButton(a.loc.T("button_queue"), func() { a.showQueue() }),
+		widget.NewButton(a.loc.T("button_settings"), func() { a.showSettings() }),
+		widget.NewButton(a.loc.T("button_hide"), func() { a.window.Hide() }),
 	)
 
 	topSection := container.NewVBox(
@@ -140,31 +264,71 @@ func (a *App) setupApp() {
 		widget.NewSeparator(),
 		buttonContainer,
 		widget.NewSeparator(),
-		widget.NewLabel("Input:"),
+		widget.NewLabel(a.loc.T("label_input")),
 		inputText,
-		widget.NewLabel("Translation:"),
+		widget.NewLabel(a.loc.T("label_translation")),
 	)
 
 	content := container.NewBorder(topSection, nil, nil, nil, resultText)
-
 	a.window.SetContent(content)
-	a.window.Show()
+}
 
-	// Load available models on startup
-	a.refreshModelDropdown()
+// refreshTrayMenu (re)builds the system tray menu. Called on startup and
+// whenever the clipboard watch toggle or language changes, since Fyne menu
+// items don't refresh their label or Checked state on their own.
+func (a *App) refreshTrayMenu() {
+	desk, ok := a.app.(desktop.App)
+	if !ok {
+		return
+	}
 
-	if desk, ok := a.app.(desktop.App); ok {
-		desk.SetSystemTrayMenu(fyne.NewMenu("lai",
-			fyne.NewMenuItem("Show", func() {
-				a.window.Show()
-				a.window.RequestFocus()
-			}),
-			fyne.NewMenuItemSeparator(),
-			fyne.NewMenuItem("Quit", func() {
-				a.app.Quit()
-			}),
-		))
+	clipboardWatchItem := fyne.NewMenuItem(a.loc.T("tray_clipboard_watch"), func() {
+		a.toggleClipboardWatch()
+	})
+	clipboardWatchItem.Checked = a.clipboardWatchRunning()
+
+	templatesItem := fyne.NewMenuItem(a.loc.T("tray_templates"), nil)
+	templatesItem.ChildMenu = a.templatesMenu()
+
+	desk.SetSystemTrayMenu(fyne.NewMenu("lai",
+		fyne.NewMenuItem(a.loc.T("tray_show"), func() {
+			a.window.Show()
+			a.window.RequestFocus()
+		}),
+		fyne.NewMenuItemSeparator(),
+		clipboardWatchItem,
+		templatesItem,
+		fyne.NewMenuItemSeparator(),
+		fyne.NewMenuItem(a.loc.T("tray_quit"), func() {
+			a.app.Quit()
+		}),
+	))
+}
+
+// templatesMenu builds the tray's "Templates" submenu, one item per known
+// template, each checked if it's the active one and selecting it on click.
+func (a *App) templatesMenu() *fyne.Menu {
+	items := make([]*fyne.MenuItem, len(a.templateList))
+	for i, t := range a.templateList {
+		name := t.Name
+		item := fyne.NewMenuItem(name, func() {
+			a.selectTemplate(name)
+		})
+		item.Checked = name == a.activeTemplate
+		items[i] = item
+	}
+	return fyne.NewMenu("", items...)
+}
+
+// selectTemplate sets the active template, persists it, and keeps the main
+// window's dropdown and the tray submenu's checkmarks in sync.
+func (a *App) selectTemplate(name string) {
+	a.activeTemplate = name
+	a.app.Preferences().SetString(prefKeyActiveTemplate, name)
+	if a.templateDropdown != nil {
+		a.templateDropdown.SetSelected(name)
 	}
+	a.refreshTrayMenu()
 }
 
 func (a *App) setupKeyboardShortcuts() {
@@ -178,36 +342,106 @@ func (a *App) setupKeyboardShortcuts() {
 		a.window.Show()
 		a.window.RequestFocus()
 	})
+
+	// Shift+Option+H for history
+	historyShortcut := &desktop.CustomShortcut{
+		KeyName:  fyne.KeyH,
+		Modifier: fyne.KeyModifierShift | fyne.KeyModifierAlt,
+	}
+	a.window.Canvas().AddShortcut(historyShortcut, func(shortcut fyne.Shortcut) {
+		a.showHistory()
+	})
+
+	// Shift+Option+1..9 select the Nth template, in list order.
+	for i, key := range numberedKeys {
+		index := i
+		shortcut := &desktop.CustomShortcut{
+			KeyName:  key,
+			Modifier: fyne.KeyModifierShift | fyne.KeyModifierAlt,
+		}
+		a.window.Canvas().AddShortcut(shortcut, func(shortcut fyne.Shortcut) {
+			if index >= len(a.templateList) {
+				return
+			}
+			a.selectTemplate(a.templateList[index].Name)
+		})
+	}
 }
 
 func (a *App) translateInputText() {
-	a.setStatus("Translating input text...")
-	a.result.Set("")
 	text, err := a.input.Get()
 	if err != nil {
-		a.setStatus("Error getting input text: %v", err)
+		a.setStatus(a.loc.T("status_error_getting_input", err))
 		return
 	}
-	go a.streamTranslateWithOllama(text)
+	a.enqueueTranslation(text)
 }
 
 func (a *App) translateClipboardText() {
-	a.setStatus("Getting clipboard text...")
+	a.setStatus(a.loc.T("status_getting_clipboard"))
 	text, err := clipboard.ReadAll()
 	if err != nil {
-		a.setStatus("Error reading clipboard: %v", err)
+		a.setStatus(a.loc.T("status_error_reading_clipboard", err))
 		return
 	}
 
 	if text == "" {
-		a.setStatus("Clipboard is empty.")
+		a.setStatus(a.loc.T("status_clipboard_empty"))
 		return
 	}
 
-	a.setStatus("Translating clipboard text...")
-	a.result.Set("")
 	a.input.Set(text)
-	go a.streamTranslateWithOllama(text)
+	a.enqueueTranslation(text)
+}
+
+// enqueueTranslation submits text as a new queue.Job and tracks its progress
+// into a.result / a.status so the main window behaves as if the translation
+// ran inline, even though it may be queued behind other in-flight jobs. It
+// returns the job so callers that need to react to its completion (e.g.
+// clipboard watch's tray notification) can track it themselves.
+func (a *App) enqueueTranslation(text string) *queue.Job {
+	a.result.Set("")
+	providerName, model := a.selectedModel()
+	job := a.queue.Enqueue(text, providerName, model, a.currentPromptTemplate())
+	a.trackJob(job.ID)
+	return job
+}
+
+// trackJob mirrors a queued job's live state into the main window until it
+// reaches a terminal status, then detaches.
+func (a *App) trackJob(id uint64) {
+	a.setStatus(a.loc.T("status_queued", id))
+
+	var listener binding.DataListener
+	listener = binding.NewDataListener(func() {
+		items, err := a.queue.Jobs.Get()
+		if err != nil {
+			return
+		}
+		for _, item := range items {
+			job, ok := item.(*queue.Job)
+			if !ok || job.ID != id {
+				continue
+			}
+
+			a.result.Set(job.Result)
+			switch job.Status {
+			case queue.StatusRunning:
+				a.setStatus(a.loc.T("status_translating", fmt.Sprintf("%.1f", job.Elapsed().Seconds())))
+			case queue.StatusDone:
+				a.setStatus(a.loc.T("status_translation_complete"))
+				a.queue.Jobs.RemoveListener(listener)
+			case queue.StatusError:
+				a.setStatus(a.loc.T("status_translation_failed", job.Err))
+				a.queue.Jobs.RemoveListener(listener)
+			case queue.StatusCancelled:
+				a.setStatus(a.loc.T("status_translation_cancelled"))
+				a.queue.Jobs.RemoveListener(listener)
+			}
+			return
+		}
+	})
+	a.queue.Jobs.AddListener(listener)
 }
 
 func (a *App) getSelectedTextWithCopy() (string, error) {
@@ -245,204 +479,449 @@ func (a *App) getSelectedTextWithCopy() (string, error) {
 	return text, nil
 }
 
-//go:embed prompt.txt
-var promptTemplate string
-
-func (a *App) streamTranslateWithOllama(text string) {
-	stopTick := make(chan struct{})
-	defer close(stopTick)
-	go func() {
-		ticker := time.Tick(100 * time.Millisecond)
-		startTime := time.Now()
-		for {
-			select {
-			case <-stopTick:
-				return
-			case now := <-ticker:
-				a.setStatus("Translating... %.1fs", now.Sub(startTime).Seconds())
-			}
-		}
-	}()
+// runQueuedTranslation is the queue.TranslateFunc that backs a.queue: it
+// resolves the job's backend, streams the translation, and records the
+// finished result to history.
+func (a *App) runQueuedTranslation(ctx context.Context, job queue.Job, onChunk func(string)) error {
+	b, ok := a.getBackend(job.Backend)
+	if !ok {
+		return fmt.Errorf("backend %q is not configured", job.Backend)
+	}
 
-	// Create a smart translation prompt
-	prompt := fmt.Sprintf(promptTemplate, text)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
 
-	reqBody := OllamaRequest{
-		Model:  a.modelName,
-		Prompt: prompt,
-		Stream: true,
+	prompt := fmt.Sprintf(job.PromptTemplate, job.Text)
+	chunks, err := b.Translate(ctx, job.Model, prompt)
+	if err != nil {
+		return fmt.Errorf("failed to translate with %s: %w", job.Backend, err)
 	}
 
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		a.setStatus("Failed to marshal request: %v", err)
-		return
+	var fullResponse strings.Builder
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			return fmt.Errorf("failed to translate with %s: %w", job.Backend, chunk.Err)
+		}
+		fullResponse.WriteString(chunk.Text)
+		onChunk(chunk.Text)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	a.recordHistory(job.Text, job.Backend, job.Model, job.PromptTemplate, fullResponse.String())
+	return nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", ollamaURL, bytes.NewBuffer(jsonData))
+// openHistory opens (or creates) the history database under the Fyne app's
+// storage directory.
+func (a *App) openHistory() {
+	path := filepath.Join(a.app.Storage().RootURI().Path(), "history.db")
+	store, err := history.Open(path)
 	if err != nil {
-		a.setStatus("Failed to create request: %v", err)
+		log.Printf("failed to open history database: %v", err)
 		return
 	}
+	a.history = store
+}
 
-	req.Header.Set("Content-Type", "application/json")
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		a.setStatus("Failed to make request to Ollama: %v", err)
+// recordHistory saves a completed translation, silently skipping if the
+// history database failed to open. backendName/model identify the job that
+// actually ran, which may no longer match the current selection by the time
+// it finishes.
+func (a *App) recordHistory(input, backendName, model, promptTemplate, output string) {
+	if a.history == nil {
 		return
 	}
-	defer resp.Body.Close()
+	_, err := a.history.Add(history.Entry{
+		Input:          input,
+		Backend:        backendName,
+		Model:          model,
+		PromptTemplate: promptTemplate,
+		Output:         output,
+	})
+	if err != nil {
+		log.Printf("failed to record history entry: %v", err)
+	}
+}
 
-	if resp.StatusCode != http.StatusOK {
-		a.setStatus("Ollama returned status %d", resp.StatusCode)
+// showHistory opens the History window, wiring up re-run so that selecting
+// an old entry and running it again streams a fresh translation and records
+// a new branch rather than overwriting the original entry.
+func (a *App) showHistory() {
+	if a.history == nil {
+		a.setStatus(a.loc.T("status_history_unavailable"))
 		return
 	}
 
-	// Stream the response
-	decoder := json.NewDecoder(resp.Body)
-	var fullResponse strings.Builder
-
-	for {
-		var ollamaResp OllamaResponse
-		if err := decoder.Decode(&ollamaResp); err != nil {
-			if err.Error() == "EOF" {
-				break
-			}
-			a.setStatus("Failed to decode response: %v", err)
-			return
+	history.NewWindow(a.app, a.loc, a.history, func(input, qualifiedModel string) {
+		a.input.Set(input)
+		a.setSelectedModel(qualifiedModel)
+		if a.modelDropdown != nil {
+			a.modelDropdown.SetSelected(qualifiedModel)
 		}
+		a.window.Show()
+		a.window.RequestFocus()
+		a.translateInputText()
+	}).Show()
+}
 
-		// Append the response chunk
-		fullResponse.WriteString(ollamaResp.Response)
-
-		// Update UI with current text
-		a.result.Set(fullResponse.String())
-
-		if ollamaResp.Done {
-			break
-		}
-	}
+// showQueue opens a window listing every translation job, past and present,
+// with cancel buttons for the ones still in flight.
+func (a *App) showQueue() {
+	queueWindow := a.app.NewWindow(a.loc.T("window_queue"))
+	queueWindow.Resize(fyne.NewSize(500, 400))
+	queueWindow.SetContent(queue.NewPanel(a.loc, a.queue))
+	queueWindow.Show()
 }
 
-func (a *App) setStatus(format string, args ...any) {
-	a.status.Set(fmt.Sprintf(format, args...))
+func (a *App) setStatus(message string) {
+	a.status.Set(message)
 }
 
-func (a *App) fetchAvailableModels() ([]string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+// selectedModel returns the currently selected backend name and bare model
+// name. Safe to call concurrently with setSelectedModel.
+func (a *App) selectedModel() (providerName, model string) {
+	a.selectionMu.RLock()
+	defer a.selectionMu.RUnlock()
+	return a.activeBackend, a.modelName
+}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", ollamaModelsURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+// qualifiedModelName returns the model name prefixed by its backend, e.g.
+// "ollama: gemma3n:e4b", which is what the dropdown shows.
+func (a *App) qualifiedModelName() string {
+	provider, model := a.selectedModel()
+	return fmt.Sprintf("%s: %s", provider, model)
+}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make request to Ollama: %w", err)
+// setSelectedModel parses a qualified dropdown entry back into the backend
+// name and bare model name. Safe to call concurrently with selectedModel,
+// since both the model dropdown's callback (UI goroutine) and
+// refreshModelDropdown's background goroutine can call this.
+func (a *App) setSelectedModel(qualified string) {
+	provider, model, found := strings.Cut(qualified, ": ")
+	a.selectionMu.Lock()
+	defer a.selectionMu.Unlock()
+	if !found {
+		a.modelName = qualified
+		return
 	}
-	defer resp.Body.Close()
+	a.activeBackend = provider
+	a.modelName = model
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("ollama returned status %d", resp.StatusCode)
-	}
+func (a *App) refreshModelDropdown() {
+	go func() {
+		a.setStatus(a.loc.T("status_loading_models"))
 
-	var modelsResp OllamaModelsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&modelsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
+		backends := a.snapshotBackends()
 
-	var modelNames []string
-	for _, model := range modelsResp.Models {
-		modelNames = append(modelNames, model.Name)
-	}
+		var options []string
+		loadedBackends := 0
+		for _, provider := range providers {
+			b, ok := backends[provider]
+			if !ok {
+				continue
+			}
 
-	return modelNames, nil
-}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			models, err := b.ListModels(ctx)
+			cancel()
+			if err != nil {
+				log.Printf("failed to list models for %s: %v", provider, err)
+				continue
+			}
+			loadedBackends++
+			for _, model := range models {
+				options = append(options, fmt.Sprintf("%s: %s", provider, model))
+			}
+		}
+		sort.Strings(options)
 
-func (a *App) refreshModelDropdown() {
-	go func() {
-		a.setStatus("Loading available models...")
-		models, err := a.fetchAvailableModels()
-		if err != nil {
-			a.setStatus("Failed to load models: %v", err)
-			// Fallback to current model if fetch fails
+		if len(options) == 0 {
+			a.setStatus(a.loc.T("status_no_models_found"))
 			if a.modelDropdown != nil {
-				a.modelDropdown.Options = []string{a.modelName}
-				a.modelDropdown.SetSelected(a.modelName)
+				a.modelDropdown.Options = []string{a.qualifiedModelName()}
+				a.modelDropdown.SetSelected(a.qualifiedModelName())
 				a.modelDropdown.Refresh()
 			}
 			return
 		}
 
-		if len(models) == 0 {
-			a.setStatus("No models found on Ollama server")
-			return
-		}
-
-		// Update dropdown options
 		if a.modelDropdown != nil {
-			a.modelDropdown.Options = models
-			// Select current model if it exists in the list, otherwise select first
+			a.modelDropdown.Options = options
 			found := false
-			for _, model := range models {
-				if model == a.modelName {
-					a.modelDropdown.SetSelected(a.modelName)
+			for _, option := range options {
+				if option == a.qualifiedModelName() {
+					a.modelDropdown.SetSelected(option)
 					found = true
 					break
 				}
 			}
-			if !found && len(models) > 0 {
-				a.modelName = models[0]
-				a.modelDropdown.SetSelected(models[0])
+			if !found {
+				a.setSelectedModel(options[0])
+				a.modelDropdown.SetSelected(options[0])
 			}
 			a.modelDropdown.Refresh()
 		}
 
-		a.setStatus("Loaded %d models from Ollama server", len(models))
+		a.setStatus(a.loc.T("status_models_loaded", len(options), loadedBackends))
 	}()
 }
 
+// loadBackends constructs a Backend for every provider enabled in
+// preferences, using the persisted API key / base URL for each.
+func (a *App) loadBackends() {
+	backends := make(map[string]backend.Backend)
+	prefs := a.app.Preferences()
+
+	if prefs.BoolWithFallback(prefKeyEnabled("ollama"), true) {
+		backends["ollama"] = &backend.Ollama{
+			BaseURL: prefs.String(prefKeyBaseURL("ollama")),
+		}
+	}
+	if prefs.Bool(prefKeyEnabled("openai")) {
+		backends["openai"] = &backend.OpenAI{
+			APIKey:  prefs.String(prefKeyAPIKey("openai")),
+			BaseURL: prefs.String(prefKeyBaseURL("openai")),
+		}
+	}
+	if prefs.Bool(prefKeyEnabled("anthropic")) {
+		backends["anthropic"] = &backend.Anthropic{
+			APIKey:  prefs.String(prefKeyAPIKey("anthropic")),
+			BaseURL: prefs.String(prefKeyBaseURL("anthropic")),
+		}
+	}
+	if prefs.Bool(prefKeyEnabled("google")) {
+		backends["google"] = &backend.Google{
+			APIKey:  prefs.String(prefKeyAPIKey("google")),
+			BaseURL: prefs.String(prefKeyBaseURL("google")),
+		}
+	}
+
+	a.setBackends(backends)
+}
+
+// getBackend returns the configured Backend for provider, if any. Safe to
+// call concurrently with loadBackends, which replaces the whole set.
+func (a *App) getBackend(provider string) (backend.Backend, bool) {
+	a.backendsMu.RLock()
+	defer a.backendsMu.RUnlock()
+	b, ok := a.backends[provider]
+	return b, ok
+}
+
+// snapshotBackends returns a copy of the currently configured backends, safe
+// to range over without holding backendsMu for the duration.
+func (a *App) snapshotBackends() map[string]backend.Backend {
+	a.backendsMu.RLock()
+	defer a.backendsMu.RUnlock()
+	snapshot := make(map[string]backend.Backend, len(a.backends))
+	for provider, b := range a.backends {
+		snapshot[provider] = b
+	}
+	return snapshot
+}
+
+// setBackends atomically replaces the configured backends.
+func (a *App) setBackends(backends map[string]backend.Backend) {
+	a.backendsMu.Lock()
+	a.backends = backends
+	a.backendsMu.Unlock()
+}
+
+func prefKeyEnabled(provider string) string { return "backend." + provider + ".enabled" }
+func prefKeyAPIKey(provider string) string  { return "backend." + provider + ".apikey" }
+func prefKeyBaseURL(provider string) string { return "backend." + provider + ".baseurl" }
+
+// showSettings opens the settings window, with one row of enabled/API
+// key/base URL controls per known backend provider, clipboard watch
+// options, and a language selector.
 func (a *App) showSettings() {
-	settingsWindow := a.app.NewWindow("Settings")
-	settingsWindow.Resize(fyne.NewSize(350, 250))
+	settingsWindow := a.app.NewWindow(a.loc.T("window_settings"))
+	settingsWindow.Resize(fyne.NewSize(450, 450))
+
+	prefs := a.app.Preferences()
+
+	type providerRow struct {
+		provider string
+		enabled  *widget.Check
+		apiKey   *widget.Entry
+		baseURL  *widget.Entry
+	}
+
+	rows := make([]providerRow, 0, len(providers))
+	form := container.NewVBox()
+	for _, provider := range providers {
+		enabled := widget.NewCheck(provider, nil)
+		enabled.SetChecked(prefs.BoolWithFallback(prefKeyEnabled(provider), provider == "ollama"))
+
+		apiKey := widget.NewEntry()
+		apiKey.Password = true
+		apiKey.SetText(prefs.String(prefKeyAPIKey(provider)))
+		apiKey.SetPlaceHolder(a.loc.T("settings_api_key_placeholder", provider))
+
+		baseURL := widget.NewEntry()
+		baseURL.SetText(prefs.String(prefKeyBaseURL(provider)))
+		baseURL.SetPlaceHolder(a.loc.T("settings_base_url_placeholder", provider))
+
+		rows = append(rows, providerRow{provider, enabled, apiKey, baseURL})
+		form.Add(container.NewVBox(
+			enabled,
+			container.NewGridWithColumns(2, apiKey, baseURL),
+			widget.NewSeparator(),
+		))
+	}
 
-	modelEntry := widget.NewEntry()
-	modelEntry.SetText(a.modelName)
-	modelEntry.SetPlaceHolder("Enter Ollama model name")
+	clipboardWatchEnabled := widget.NewCheck(a.loc.T("settings_clipboard_watch"), nil)
+	clipboardWatchEnabled.SetChecked(prefs.Bool(prefKeyClipboardWatchEnabled))
 
-	ollamaURLEntry := widget.NewEntry()
-	ollamaURLEntry.SetText(ollamaURL)
-	ollamaURLEntry.SetPlaceHolder("Ollama server URL")
+	clipboardWatchDebounce := widget.NewEntry()
+	clipboardWatchDebounce.SetText(fmt.Sprintf("%d", prefs.IntWithFallback(prefKeyClipboardWatchDebounceMs, defaultClipboardDebounceMs)))
+	clipboardWatchDebounce.SetPlaceHolder(a.loc.T("settings_debounce_placeholder"))
+
+	clipboardWatchMaxLen := widget.NewEntry()
+	clipboardWatchMaxLen.SetText(fmt.Sprintf("%d", prefs.IntWithFallback(prefKeyClipboardWatchMaxLen, defaultClipboardMaxLen)))
+	clipboardWatchMaxLen.SetPlaceHolder(a.loc.T("settings_max_len_placeholder"))
+
+	language := widget.NewSelect(locale.Languages, nil)
+	language.SetSelected(a.loc.Language())
+
+	type templateRow struct {
+		name   *widget.Entry
+		prompt *widget.Entry
+	}
+	var templateRows []templateRow
+	templateForm := container.NewVBox()
+
+	var rebuildTemplateForm func()
+	addTemplateRow := func(name, prompt string) {
+		nameEntry := widget.NewEntry()
+		nameEntry.SetText(name)
+		nameEntry.SetPlaceHolder(a.loc.T("settings_template_name_placeholder"))
+
+		promptEntry := widget.NewMultiLineEntry()
+		promptEntry.SetText(prompt)
+		promptEntry.SetPlaceHolder(a.loc.T("settings_template_prompt_placeholder"))
+
+		row := templateRow{nameEntry, promptEntry}
+		templateRows = append(templateRows, row)
+
+		deleteBtn := widget.NewButton(a.loc.T("settings_template_delete"), func() {
+			for i, r := range templateRows {
+				if r == row {
+					templateRows = append(templateRows[:i], templateRows[i+1:]...)
+					break
+				}
+			}
+			rebuildTemplateForm()
+		})
 
-	saveBtn := widget.NewButton("Save", func() {
-		// Update the model name
-		a.modelName = modelEntry.Text
-		a.setStatus("Settings saved. Using model: %s", a.modelName)
+		templateForm.Add(container.NewVBox(
+			container.NewBorder(nil, nil, nil, deleteBtn, nameEntry),
+			promptEntry,
+			widget.NewSeparator(),
+		))
+	}
+	rebuildTemplateForm = func() {
+		templateForm.RemoveAll()
+		rows := templateRows
+		templateRows = nil
+		for _, r := range rows {
+			addTemplateRow(r.name.Text, r.prompt.Text)
+		}
+	}
+	for _, t := range a.templateList {
+		addTemplateRow(t.Name, t.Prompt)
+	}
+
+	addTemplateBtn := widget.NewButton(a.loc.T("settings_template_add"), func() {
+		addTemplateRow("", "")
+	})
+
+	saveBtn := widget.NewButton(a.loc.T("settings_save"), func() {
+		for _, row := range rows {
+			prefs.SetBool(prefKeyEnabled(row.provider), row.enabled.Checked)
+			prefs.SetString(prefKeyAPIKey(row.provider), row.apiKey.Text)
+			prefs.SetString(prefKeyBaseURL(row.provider), row.baseURL.Text)
+		}
+		a.loadBackends()
+		a.refreshModelDropdown()
+
+		prefs.SetBool(prefKeyClipboardWatchEnabled, clipboardWatchEnabled.Checked)
+		if ms, err := strconv.Atoi(clipboardWatchDebounce.Text); err == nil {
+			prefs.SetInt(prefKeyClipboardWatchDebounceMs, ms)
+		}
+		if n, err := strconv.Atoi(clipboardWatchMaxLen.Text); err == nil {
+			prefs.SetInt(prefKeyClipboardWatchMaxLen, n)
+		}
+		if clipboardWatchEnabled.Checked {
+			a.startClipboardWatch()
+		} else {
+			a.stopClipboardWatch()
+		}
+
+		if language.Selected != "" && language.Selected != a.loc.Language() {
+			prefs.SetString(prefKeyLanguage, language.Selected)
+			a.loc.SetLanguage(language.Selected)
+			a.renderMainWindow()
+		}
+
+		newTemplates := make([]templates.Template, 0, len(templateRows))
+		invalid := false
+		for _, row := range templateRows {
+			name := strings.TrimSpace(row.name.Text)
+			if name == "" {
+				continue
+			}
+			t := templates.Template{Name: name, Prompt: row.prompt.Text}
+			if err := templates.Validate(t); err != nil {
+				log.Printf("skipping invalid template: %v", err)
+				invalid = true
+				continue
+			}
+			newTemplates = append(newTemplates, t)
+		}
+		if len(newTemplates) == 0 {
+			newTemplates = templates.Defaults
+		}
+		if data, err := templates.Marshal(newTemplates); err != nil {
+			log.Printf("failed to marshal templates: %v", err)
+		} else {
+			prefs.SetString(prefKeyTemplates, data)
+		}
+		a.loadTemplates()
+		a.refreshTemplateDropdown()
+		a.refreshTrayMenu()
+
+		if invalid {
+			a.setStatus(a.loc.T("status_template_invalid"))
+		} else {
+			a.setStatus(a.loc.T("status_settings_saved"))
+		}
 		settingsWindow.Close()
 	})
 
-	cancelBtn := widget.NewButton("Cancel", func() {
+	cancelBtn := widget.NewButton(a.loc.T("settings_cancel"), func() {
 		settingsWindow.Close()
 	})
 
-	buttonContainer := container.NewHBox(saveBtn, cancelBtn)
+	backendsTab := container.NewVBox(
+		widget.NewLabel(a.loc.T("settings_backends")),
+		form,
+		clipboardWatchEnabled,
+		container.NewGridWithColumns(2, clipboardWatchDebounce, clipboardWatchMaxLen),
+		widget.NewSeparator(),
+		widget.NewLabel(a.loc.T("settings_language")),
+		language,
+	)
+
+	templatesTab := container.NewBorder(nil, addTemplateBtn, nil, nil, container.NewVScroll(templateForm))
 
-	content := container.NewVBox(
-		widget.NewLabel("Ollama Model:"),
-		modelEntry,
-		widget.NewLabel("Ollama URL:"),
-		ollamaURLEntry,
-		widget.NewLabel("Note: URL changes require app restart"),
-		buttonContainer,
+	tabs := container.NewAppTabs(
+		container.NewTabItem(a.loc.T("settings_backends"), container.NewVScroll(backendsTab)),
+		container.NewTabItem(a.loc.T("settings_templates"), templatesTab),
 	)
 
+	content := container.NewBorder(nil, container.NewHBox(saveBtn, cancelBtn), nil, nil, tabs)
+
 	settingsWindow.SetContent(content)
 	settingsWindow.Show()
 }
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/data/binding"
+	"github.com/atotto/clipboard"
+
+	"github.com/luckygeck/lai/queue"
+)
+
+// clipboardWatchNotificationMaxLen caps how much of a clipboard-watch
+// translation is shown in the tray notification body.
+const clipboardWatchNotificationMaxLen = 200
+
+const (
+	prefKeyClipboardWatchEnabled    = "clipboardwatch.enabled"
+	prefKeyClipboardWatchDebounceMs = "clipboardwatch.debounce_ms"
+	prefKeyClipboardWatchMaxLen     = "clipboardwatch.maxlen"
+
+	clipboardPollInterval      = 300 * time.Millisecond
+	defaultClipboardDebounceMs = 1000
+	defaultClipboardMaxLen     = 2000
+
+	clipboardWatchMinLen = 3
+)
+
+// clipboardWatchRunning reports whether the background poller is active.
+func (a *App) clipboardWatchRunning() bool {
+	return a.clipboardWatchStop != nil
+}
+
+// toggleClipboardWatch flips clipboard watch on or off, persists the new
+// state, and refreshes the tray menu's checkmark.
+func (a *App) toggleClipboardWatch() {
+	if a.clipboardWatchRunning() {
+		a.stopClipboardWatch()
+	} else {
+		a.startClipboardWatch()
+	}
+	a.app.Preferences().SetBool(prefKeyClipboardWatchEnabled, a.clipboardWatchRunning())
+	a.refreshTrayMenu()
+}
+
+// startClipboardWatch begins polling the clipboard on a ticker. When its
+// content changes to something that looks like natural-language text, it is
+// translated automatically. It is a no-op if already running.
+func (a *App) startClipboardWatch() {
+	if a.clipboardWatchRunning() {
+		return
+	}
+
+	stop := make(chan struct{})
+	a.clipboardWatchStop = stop
+
+	// Seed lastSeenClipboard so the current clipboard contents (copied
+	// before watch mode was turned on) don't trigger an immediate
+	// translation. Note this only dedupes against re-seeing the same input
+	// text: the app never writes the translation itself back to the
+	// clipboard, so there's no risk yet of translating our own output in a
+	// loop. If that ever changes, the write must update lastSeenClipboard
+	// too.
+	a.lastSeenClipboard, _ = clipboard.ReadAll()
+
+	go func() {
+		ticker := time.NewTicker(clipboardPollInterval)
+		defer ticker.Stop()
+
+		var lastTranslated time.Time
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				text, err := clipboard.ReadAll()
+				if err != nil || text == a.lastSeenClipboard {
+					continue
+				}
+				a.lastSeenClipboard = text
+
+				if !looksLikeNaturalLanguage(text, a.clipboardMaxLen()) {
+					continue
+				}
+				if time.Since(lastTranslated) < a.clipboardDebounce() {
+					continue
+				}
+				lastTranslated = time.Now()
+
+				a.setStatus(a.loc.T("status_clipboard_watch_translating"))
+				a.input.Set(text)
+				job := a.enqueueTranslation(text)
+				a.notifyOnClipboardTranslation(job.ID)
+			}
+		}
+	}()
+}
+
+// stopClipboardWatch stops the background poller started by
+// startClipboardWatch. It is a no-op if not running.
+func (a *App) stopClipboardWatch() {
+	if !a.clipboardWatchRunning() {
+		return
+	}
+	close(a.clipboardWatchStop)
+	a.clipboardWatchStop = nil
+}
+
+// notifyOnClipboardTranslation surfaces a tray notification once the given
+// job finishes, so a clipboard-watch translation is visible even when the
+// main window is hidden.
+func (a *App) notifyOnClipboardTranslation(id uint64) {
+	var listener binding.DataListener
+	listener = binding.NewDataListener(func() {
+		items, err := a.queue.Jobs.Get()
+		if err != nil {
+			return
+		}
+		for _, item := range items {
+			job, ok := item.(*queue.Job)
+			if !ok || job.ID != id {
+				continue
+			}
+			switch job.Status {
+			case queue.StatusDone:
+				a.app.SendNotification(fyne.NewNotification(
+					a.loc.T("tray_clipboard_watch"),
+					truncate(job.Result, clipboardWatchNotificationMaxLen),
+				))
+				a.queue.Jobs.RemoveListener(listener)
+			case queue.StatusError, queue.StatusCancelled:
+				a.queue.Jobs.RemoveListener(listener)
+			}
+			return
+		}
+	})
+	a.queue.Jobs.AddListener(listener)
+}
+
+// truncate shortens s to at most n runes, appending an ellipsis if it had to.
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
+
+func (a *App) clipboardDebounce() time.Duration {
+	ms := a.app.Preferences().IntWithFallback(prefKeyClipboardWatchDebounceMs, defaultClipboardDebounceMs)
+	return time.Duration(ms) * time.Millisecond
+}
+
+func (a *App) clipboardMaxLen() int {
+	return a.app.Preferences().IntWithFallback(prefKeyClipboardWatchMaxLen, defaultClipboardMaxLen)
+}
+
+// looksLikeNaturalLanguage applies a cheap heuristic to decide whether
+// clipboard content is worth auto-translating: long enough to be a
+// sentence, short enough to not be a giant paste or file dump, valid UTF-8
+// with no embedded NUL bytes, and not a bare URL.
+func looksLikeNaturalLanguage(text string, maxLen int) bool {
+	trimmed := strings.TrimSpace(text)
+
+	if n := utf8.RuneCountInString(trimmed); n < clipboardWatchMinLen || n > maxLen {
+		return false
+	}
+	if !utf8.ValidString(trimmed) || strings.ContainsRune(trimmed, 0) {
+		return false
+	}
+	if strings.HasPrefix(trimmed, "http://") || strings.HasPrefix(trimmed, "https://") {
+		return false
+	}
+
+	return true
+}
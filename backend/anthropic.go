@@ -0,0 +1,144 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const defaultAnthropicBaseURL = "https://api.anthropic.com/v1"
+
+// Anthropic talks to the Anthropic Messages API, streaming the response via
+// server-sent events.
+type Anthropic struct {
+	APIKey  string
+	BaseURL string // e.g. "https://api.anthropic.com/v1", empty means the default
+}
+
+type anthropicMessagesRequest struct {
+	Model     string              `json:"model"`
+	Stream    bool                `json:"stream"`
+	MaxTokens int                 `json:"max_tokens"`
+	Messages  []openAIChatMessage `json:"messages"`
+}
+
+type anthropicStreamEvent struct {
+	Type  string `json:"type"`
+	Delta struct {
+		Text string `json:"text"`
+	} `json:"delta"`
+}
+
+func (a *Anthropic) Name() string { return "anthropic" }
+
+func (a *Anthropic) baseURL() string {
+	if a.BaseURL == "" {
+		return defaultAnthropicBaseURL
+	}
+	return strings.TrimRight(a.BaseURL, "/")
+}
+
+func (a *Anthropic) Translate(ctx context.Context, model, prompt string) (<-chan Chunk, error) {
+	reqBody := anthropicMessagesRequest{
+		Model:     model,
+		Stream:    true,
+		MaxTokens: 4096,
+		Messages: []openAIChatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", a.baseURL()+"/messages", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-api-key", a.APIKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Anthropic: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("anthropic returned status %d", resp.StatusCode)
+	}
+
+	chunks := make(chan Chunk)
+	go func() {
+		defer close(chunks)
+		defer resp.Body.Close()
+
+		scanner := newSSEScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+
+			var event anthropicStreamEvent
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event); err != nil {
+				chunks <- Chunk{Err: fmt.Errorf("failed to decode response: %w", err)}
+				return
+			}
+			if event.Type == "content_block_delta" {
+				chunks <- Chunk{Text: event.Delta.Text}
+			}
+			if event.Type == "message_stop" {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			chunks <- Chunk{Err: fmt.Errorf("failed to read response: %w", err)}
+		}
+	}()
+
+	return chunks, nil
+}
+
+func (a *Anthropic) ListModels(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", a.baseURL()+"/models", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("x-api-key", a.APIKey)
+	req.Header.Set("anthropic-version", "2023-06-01")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Anthropic: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("anthropic returned status %d", resp.StatusCode)
+	}
+
+	var modelsResp struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&modelsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var modelNames []string
+	for _, model := range modelsResp.Data {
+		modelNames = append(modelNames, model.ID)
+	}
+
+	return modelNames, nil
+}
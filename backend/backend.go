@@ -0,0 +1,55 @@
+// Package backend defines the translation provider interface used by
+// app.App and the concrete providers it ships with (Ollama, OpenAI-compatible,
+// Anthropic, and Google Gemini).
+package backend
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// Backend is a translation provider. Implementations own their own HTTP
+// client and configuration (API key, base URL) and are expected to be cheap
+// to construct.
+type Backend interface {
+	// Translate sends prompt to the backend using model and streams the
+	// response back chunk by chunk on the returned channel. The channel is
+	// closed when the response is complete. A non-nil error means the
+	// request could not be started at all; a mid-stream failure is reported
+	// as a Chunk with Err set rather than a partial, silently-truncated
+	// result.
+	Translate(ctx context.Context, model, prompt string) (<-chan Chunk, error)
+
+	// ListModels returns the model names currently available from this
+	// backend.
+	ListModels(ctx context.Context) ([]string, error)
+
+	// Name identifies the provider, e.g. "ollama", "openai", "anthropic",
+	// "google". It is the same string app.App uses as the provider's model
+	// name prefix and settings key.
+	Name() string
+}
+
+// Chunk is one piece of a streamed Translate response. Text may be empty
+// (e.g. a provider's final or role-only message); a Chunk with Err set
+// carries no Text and is always the last one sent before the channel closes.
+type Chunk struct {
+	Text string
+	Err  error
+}
+
+// maxSSELineSize bounds how long a single server-sent-event line may be,
+// well above bufio.Scanner's 64KB default so one long completion doesn't
+// trip bufio.ErrTooLong mid-stream.
+const maxSSELineSize = 1 << 20
+
+// newSSEScanner returns a line scanner sized for the SSE-based backends
+// (OpenAI, Anthropic, Google). Callers must still check scanner.Err() after
+// the scan loop exits, since Scan returning false doesn't distinguish a
+// clean EOF from a dropped connection or an over-long line.
+func newSSEScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxSSELineSize)
+	return scanner
+}
@@ -0,0 +1,141 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const defaultGoogleBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+
+// Google talks to the Gemini API's streamGenerateContent endpoint.
+type Google struct {
+	APIKey  string
+	BaseURL string // e.g. "https://generativelanguage.googleapis.com/v1beta", empty means the default
+}
+
+type googleGenerateRequest struct {
+	Contents []googleContent `json:"contents"`
+}
+
+type googleContent struct {
+	Parts []googlePart `json:"parts"`
+}
+
+type googlePart struct {
+	Text string `json:"text"`
+}
+
+type googleStreamChunk struct {
+	Candidates []struct {
+		Content googleContent `json:"content"`
+	} `json:"candidates"`
+}
+
+func (g *Google) Name() string { return "google" }
+
+func (g *Google) baseURL() string {
+	if g.BaseURL == "" {
+		return defaultGoogleBaseURL
+	}
+	return strings.TrimRight(g.BaseURL, "/")
+}
+
+func (g *Google) Translate(ctx context.Context, model, prompt string) (<-chan Chunk, error) {
+	reqBody := googleGenerateRequest{
+		Contents: []googleContent{
+			{Parts: []googlePart{{Text: prompt}}},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/models/%s:streamGenerateContent?alt=sse&key=%s", g.baseURL(), model, g.APIKey)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Google: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("google returned status %d", resp.StatusCode)
+	}
+
+	chunks := make(chan Chunk)
+	go func() {
+		defer close(chunks)
+		defer resp.Body.Close()
+
+		scanner := newSSEScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+
+			var chunk googleStreamChunk
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &chunk); err != nil {
+				chunks <- Chunk{Err: fmt.Errorf("failed to decode response: %w", err)}
+				return
+			}
+			for _, candidate := range chunk.Candidates {
+				for _, part := range candidate.Content.Parts {
+					chunks <- Chunk{Text: part.Text}
+				}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			chunks <- Chunk{Err: fmt.Errorf("failed to read response: %w", err)}
+		}
+	}()
+
+	return chunks, nil
+}
+
+func (g *Google) ListModels(ctx context.Context) ([]string, error) {
+	url := fmt.Sprintf("%s/models?key=%s", g.baseURL(), g.APIKey)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Google: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("google returned status %d", resp.StatusCode)
+	}
+
+	var modelsResp struct {
+		Models []struct {
+			Name string `json:"name"`
+		} `json:"models"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&modelsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var modelNames []string
+	for _, model := range modelsResp.Models {
+		modelNames = append(modelNames, strings.TrimPrefix(model.Name, "models/"))
+	}
+
+	return modelNames, nil
+}
@@ -0,0 +1,143 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultOllamaURL       = "http://localhost:11434/api/generate"
+	defaultOllamaModelsURL = "http://localhost:11434/api/tags"
+)
+
+// Ollama talks to a local (or remote) Ollama server's generate and tags
+// endpoints.
+type Ollama struct {
+	BaseURL string // e.g. "http://localhost:11434", empty means the default
+}
+
+type ollamaRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+	Stream bool   `json:"stream"`
+}
+
+type ollamaResponse struct {
+	Response string `json:"response"`
+	Done     bool   `json:"done"`
+}
+
+type ollamaModel struct {
+	Name       string    `json:"name"`
+	ModifiedAt time.Time `json:"modified_at"`
+	Size       int64     `json:"size"`
+}
+
+type ollamaModelsResponse struct {
+	Models []ollamaModel `json:"models"`
+}
+
+func (o *Ollama) Name() string { return "ollama" }
+
+func (o *Ollama) generateURL() string {
+	if o.BaseURL == "" {
+		return defaultOllamaURL
+	}
+	return o.BaseURL + "/api/generate"
+}
+
+func (o *Ollama) modelsURL() string {
+	if o.BaseURL == "" {
+		return defaultOllamaModelsURL
+	}
+	return o.BaseURL + "/api/tags"
+}
+
+func (o *Ollama) Translate(ctx context.Context, model, prompt string) (<-chan Chunk, error) {
+	reqBody := ollamaRequest{
+		Model:  model,
+		Prompt: prompt,
+		Stream: true,
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.generateURL(), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Ollama: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("ollama returned status %d", resp.StatusCode)
+	}
+
+	chunks := make(chan Chunk)
+	go func() {
+		defer close(chunks)
+		defer resp.Body.Close()
+
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var chunk ollamaResponse
+			if err := decoder.Decode(&chunk); err != nil {
+				if err.Error() != "EOF" {
+					chunks <- Chunk{Err: fmt.Errorf("failed to decode response: %w", err)}
+				}
+				return
+			}
+
+			chunks <- Chunk{Text: chunk.Response}
+
+			if chunk.Done {
+				return
+			}
+		}
+	}()
+
+	return chunks, nil
+}
+
+func (o *Ollama) ListModels(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", o.modelsURL(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Ollama: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama returned status %d", resp.StatusCode)
+	}
+
+	var modelsResp ollamaModelsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&modelsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var modelNames []string
+	for _, model := range modelsResp.Models {
+		modelNames = append(modelNames, model.Name)
+	}
+
+	return modelNames, nil
+}
@@ -0,0 +1,150 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const defaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+// OpenAI talks to any OpenAI-compatible chat/completions API (OpenAI itself,
+// or a self-hosted proxy that speaks the same protocol) using streamed
+// server-sent events.
+type OpenAI struct {
+	APIKey  string
+	BaseURL string // e.g. "https://api.openai.com/v1", empty means the default
+}
+
+type openAIChatRequest struct {
+	Model    string              `json:"model"`
+	Stream   bool                `json:"stream"`
+	Messages []openAIChatMessage `json:"messages"`
+}
+
+type openAIChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type openAIChatChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+type openAIModelsResponse struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+func (o *OpenAI) Name() string { return "openai" }
+
+func (o *OpenAI) baseURL() string {
+	if o.BaseURL == "" {
+		return defaultOpenAIBaseURL
+	}
+	return strings.TrimRight(o.BaseURL, "/")
+}
+
+func (o *OpenAI) Translate(ctx context.Context, model, prompt string) (<-chan Chunk, error) {
+	reqBody := openAIChatRequest{
+		Model:  model,
+		Stream: true,
+		Messages: []openAIChatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", o.baseURL()+"/chat/completions", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+o.APIKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to OpenAI: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("openai returned status %d", resp.StatusCode)
+	}
+
+	chunks := make(chan Chunk)
+	go func() {
+		defer close(chunks)
+		defer resp.Body.Close()
+
+		scanner := newSSEScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+			data := strings.TrimPrefix(line, "data: ")
+			if data == "[DONE]" {
+				return
+			}
+
+			var chunk openAIChatChunk
+			if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+				chunks <- Chunk{Err: fmt.Errorf("failed to decode response: %w", err)}
+				return
+			}
+			if len(chunk.Choices) > 0 {
+				chunks <- Chunk{Text: chunk.Choices[0].Delta.Content}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			chunks <- Chunk{Err: fmt.Errorf("failed to read response: %w", err)}
+		}
+	}()
+
+	return chunks, nil
+}
+
+func (o *OpenAI) ListModels(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", o.baseURL()+"/models", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+o.APIKey)
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to OpenAI: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("openai returned status %d", resp.StatusCode)
+	}
+
+	var modelsResp openAIModelsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&modelsResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	var modelNames []string
+	for _, model := range modelsResp.Data {
+		modelNames = append(modelNames, model.ID)
+	}
+
+	return modelNames, nil
+}
@@ -0,0 +1,142 @@
+// Package history persists past translations to a local BoltDB file so they
+// can be searched, re-run against a different model, and exported.
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var entriesBucket = []byte("entries")
+
+// Entry is a single recorded translation.
+type Entry struct {
+	ID             uint64    `json:"id"`
+	Input          string    `json:"input"`
+	Backend        string    `json:"backend"`
+	Model          string    `json:"model"`
+	PromptTemplate string    `json:"prompt_template"`
+	Output         string    `json:"output"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Store is a handle to the on-disk history database. It is safe for
+// concurrent use.
+type Store struct {
+	db *bolt.DB
+}
+
+// Open creates or opens the history database at path, creating the entries
+// bucket if it doesn't exist yet.
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history database: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(entriesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create entries bucket: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the underlying database file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Add records a new entry, assigning it an ID and a creation timestamp, and
+// returns the stored copy.
+func (s *Store) Add(e Entry) (Entry, error) {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(entriesBucket)
+
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return err
+		}
+		e.ID = id
+		e.CreatedAt = time.Now()
+
+		data, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		return bucket.Put(itob(id), data)
+	})
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to add history entry: %w", err)
+	}
+
+	return e, nil
+}
+
+// List returns every recorded entry, most recent first.
+func (s *Store) List() ([]Entry, error) {
+	var entries []Entry
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(entriesBucket)
+		return bucket.ForEach(func(_, data []byte) error {
+			var e Entry
+			if err := json.Unmarshal(data, &e); err != nil {
+				return err
+			}
+			entries = append(entries, e)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list history entries: %w", err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+
+	return entries, nil
+}
+
+// Delete removes the entry with the given ID, if it exists.
+func (s *Store) Delete(id uint64) error {
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(entriesBucket).Delete(itob(id))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete history entry: %w", err)
+	}
+	return nil
+}
+
+// Export writes every entry as a JSON array.
+func (s *Store) Export() ([]byte, error) {
+	entries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal history for export: %w", err)
+	}
+	return data, nil
+}
+
+func itob(id uint64) []byte {
+	b := make([]byte, 8)
+	for i := 7; i >= 0; i-- {
+		b[i] = byte(id)
+		id >>= 8
+	}
+	return b
+}
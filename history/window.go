@@ -0,0 +1,150 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/luckygeck/lai/locale"
+)
+
+// RerunFunc re-runs an entry's (possibly edited) input against the given
+// qualified model name (e.g. "ollama: gemma3n:e4b"), creating a new history
+// entry rather than modifying the one it started from.
+type RerunFunc func(input, qualifiedModel string)
+
+// NewWindow builds the History window: a searchable list of past
+// translations that can be deleted, exported, or re-run (optionally after
+// editing the input or model) to create a new branch.
+func NewWindow(app fyne.App, loc *locale.Catalog, store *Store, rerun RerunFunc) fyne.Window {
+	window := app.NewWindow(loc.T("window_history"))
+	window.Resize(fyne.NewSize(700, 500))
+
+	var all []Entry
+	var filtered []Entry
+
+	list := widget.NewList(
+		func() int { return len(filtered) },
+		func() fyne.CanvasObject { return widget.NewLabel("") },
+		func(i widget.ListItemID, o fyne.CanvasObject) {
+			e := filtered[i]
+			o.(*widget.Label).SetText(fmt.Sprintf("[%s] %s: %s", e.CreatedAt.Format("2006-01-02 15:04"), e.Model, truncate(e.Input, 60)))
+		},
+	)
+
+	reload := func() {
+		entries, err := store.List()
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		all = entries
+		filtered = entries
+		list.Refresh()
+	}
+
+	search := widget.NewEntry()
+	search.SetPlaceHolder(loc.T("history_search_placeholder"))
+	search.OnChanged = func(query string) {
+		if query == "" {
+			filtered = all
+		} else {
+			filtered = nil
+			lower := strings.ToLower(query)
+			for _, e := range all {
+				if strings.Contains(strings.ToLower(e.Input), lower) ||
+					strings.Contains(strings.ToLower(e.Output), lower) {
+					filtered = append(filtered, e)
+				}
+			}
+		}
+		list.Refresh()
+	}
+
+	inputEntry := widget.NewMultiLineEntry()
+	inputEntry.SetPlaceHolder(loc.T("history_input_placeholder"))
+	inputEntry.Wrapping = fyne.TextWrapWord
+
+	outputLabel := widget.NewLabel("")
+	outputLabel.Wrapping = fyne.TextWrapWord
+
+	modelEntry := widget.NewEntry()
+	modelEntry.SetPlaceHolder(loc.T("history_model_placeholder"))
+
+	var selected *Entry
+	list.OnSelected = func(i widget.ListItemID) {
+		e := filtered[i]
+		selected = &e
+		inputEntry.SetText(e.Input)
+		outputLabel.SetText(e.Output)
+		modelEntry.SetText(fmt.Sprintf("%s: %s", e.Backend, e.Model))
+	}
+
+	rerunBtn := widget.NewButton(loc.T("history_rerun"), func() {
+		if selected == nil {
+			return
+		}
+		rerun(inputEntry.Text, modelEntry.Text)
+	})
+
+	deleteBtn := widget.NewButton(loc.T("history_delete"), func() {
+		if selected == nil {
+			return
+		}
+		if err := store.Delete(selected.ID); err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		selected = nil
+		reload()
+	})
+
+	exportBtn := widget.NewButton(loc.T("history_export"), func() {
+		data, err := store.Export()
+		if err != nil {
+			dialog.ShowError(err, window)
+			return
+		}
+		saveDialog := dialog.NewFileSave(func(writer fyne.URIWriteCloser, err error) {
+			if err != nil || writer == nil {
+				return
+			}
+			defer writer.Close()
+			writer.Write(data)
+		}, window)
+		saveDialog.SetFileName("lai-history.json")
+		saveDialog.Show()
+	})
+
+	detail := container.NewVBox(
+		widget.NewLabel(loc.T("history_label_input")),
+		inputEntry,
+		widget.NewLabel(loc.T("history_label_model")),
+		modelEntry,
+		widget.NewLabel(loc.T("history_label_output")),
+		outputLabel,
+		container.NewHBox(rerunBtn, deleteBtn, exportBtn),
+	)
+
+	split := container.NewHSplit(
+		container.NewBorder(search, nil, nil, nil, list),
+		detail,
+	)
+	split.Offset = 0.35
+
+	window.SetContent(split)
+	reload()
+
+	return window
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,79 @@
+// Package locale loads the go-i18n message catalogs embedded at build time
+// and exposes a T helper for translating lai's own UI strings.
+package locale
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+//go:embed catalog/*.toml
+var catalogFS embed.FS
+
+// Languages lists the locale tags shipped with the app, in display order.
+var Languages = []string{"en", "ru"}
+
+// DefaultLanguage is used when no language preference has been saved yet,
+// or the saved one fails to load.
+const DefaultLanguage = "en"
+
+// Catalog holds the loaded message bundle and the active localizer.
+type Catalog struct {
+	bundle    *i18n.Bundle
+	localizer *i18n.Localizer
+	lang      string
+}
+
+// New loads every embedded catalog and returns a Catalog with lang as the
+// active language, falling back to DefaultLanguage for any missing keys.
+func New(lang string) (*Catalog, error) {
+	bundle := i18n.NewBundle(language.English)
+	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
+
+	for _, l := range Languages {
+		if _, err := bundle.LoadMessageFileFS(catalogFS, fmt.Sprintf("catalog/%s.toml", l)); err != nil {
+			return nil, fmt.Errorf("failed to load %s catalog: %w", l, err)
+		}
+	}
+
+	c := &Catalog{bundle: bundle}
+	c.SetLanguage(lang)
+	return c, nil
+}
+
+// SetLanguage switches the active language without reloading catalogs.
+func (c *Catalog) SetLanguage(lang string) {
+	c.lang = lang
+	c.localizer = i18n.NewLocalizer(c.bundle, lang, DefaultLanguage)
+}
+
+// Language returns the currently active language tag.
+func (c *Catalog) Language() string {
+	return c.lang
+}
+
+// T translates key, substituting args positionally as arg0, arg1, ... for
+// catalog entries that reference them as "{{.arg0}}". Unknown keys are
+// returned verbatim so a missing translation is visible rather than silent.
+func (c *Catalog) T(key string, args ...any) string {
+	var data map[string]any
+	if len(args) > 0 {
+		data = make(map[string]any, len(args))
+		for i, a := range args {
+			data[fmt.Sprintf("arg%d", i)] = a
+		}
+	}
+
+	msg, err := c.localizer.Localize(&i18n.LocalizeConfig{
+		MessageID:    key,
+		TemplateData: data,
+	})
+	if err != nil {
+		return key
+	}
+	return msg
+}
@@ -0,0 +1,63 @@
+package queue
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/luckygeck/lai/locale"
+)
+
+// NewPanel builds a "Queue" panel: one row per job showing its status and
+// elapsed time, with a Cancel button enabled for jobs that are still queued
+// or running.
+func NewPanel(loc *locale.Catalog, q *Queue) fyne.CanvasObject {
+	list := widget.NewList(
+		func() int {
+			n, _ := q.Jobs.Length()
+			return n
+		},
+		func() fyne.CanvasObject {
+			return container.NewBorder(nil, nil, nil, widget.NewButton(loc.T("queue_cancel"), nil), widget.NewLabel(""))
+		},
+		func(i widget.ListItemID, o fyne.CanvasObject) {
+			items, err := q.Jobs.Get()
+			if err != nil || i >= len(items) {
+				return
+			}
+			job, ok := items[i].(*Job)
+			if !ok {
+				return
+			}
+
+			row := o.(*fyne.Container)
+			label := row.Objects[0].(*widget.Label)
+			cancelBtn := row.Objects[1].(*widget.Button)
+
+			label.SetText(loc.T("queue_row", job.ID, job.Status, job.Model, truncate(job.Text, 40), fmt.Sprintf("%.1f", job.Elapsed().Seconds())))
+
+			cancelBtn.OnTapped = func() { q.Cancel(job.ID) }
+			if job.Status == StatusQueued || job.Status == StatusRunning {
+				cancelBtn.Enable()
+			} else {
+				cancelBtn.Disable()
+			}
+		},
+	)
+
+	q.Jobs.AddListener(binding.NewDataListener(func() {
+		list.Refresh()
+	}))
+
+	return container.NewVScroll(list)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,184 @@
+// Package queue runs translation jobs with bounded concurrency so that
+// pasting several clipboard snippets in a row enqueues them instead of one
+// clobbering another's in-flight request, and exposes their live state for a
+// UI to render.
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// Status is the lifecycle state of a Job.
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusDone      Status = "done"
+	StatusError     Status = "error"
+	StatusCancelled Status = "cancelled"
+)
+
+// Job describes one translation request and its current state.
+type Job struct {
+	ID      uint64
+	Text    string
+	Backend string
+	// Model is the backend-specific model name to translate with; the
+	// configured TranslateFunc is responsible for passing it to the backend.
+	Model          string
+	PromptTemplate string
+
+	Status    Status
+	Result    string
+	Err       error
+	QueuedAt  time.Time
+	StartedAt time.Time
+	EndedAt   time.Time
+
+	cancel context.CancelFunc
+}
+
+// Elapsed returns how long the job has been running, or ran for once it
+// finishes. It is zero while the job is still queued.
+func (j Job) Elapsed() time.Duration {
+	switch {
+	case j.StartedAt.IsZero():
+		return 0
+	case j.EndedAt.IsZero():
+		return time.Since(j.StartedAt)
+	default:
+		return j.EndedAt.Sub(j.StartedAt)
+	}
+}
+
+// TranslateFunc performs a job's translation, streaming incremental output
+// via onChunk, and respecting ctx cancellation.
+type TranslateFunc func(ctx context.Context, job Job, onChunk func(string)) error
+
+// Queue runs jobs with bounded concurrency, exposing their live state
+// through Jobs for a UI to render.
+type Queue struct {
+	translate   TranslateFunc
+	concurrency int
+	sem         chan struct{}
+
+	mu     sync.Mutex
+	jobs   []*Job
+	nextID uint64
+
+	// Jobs holds a []*Job snapshot, refreshed after every state change.
+	Jobs binding.UntypedList
+}
+
+// New creates a Queue that runs up to concurrency jobs at once (values below
+// 1 are treated as 1, i.e. strictly serial) using translate to do the work.
+func New(translate TranslateFunc, concurrency int) *Queue {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Queue{
+		translate:   translate,
+		concurrency: concurrency,
+		sem:         make(chan struct{}, concurrency),
+		Jobs:        binding.NewUntypedList(),
+	}
+}
+
+// Enqueue adds a new job and starts it as soon as a concurrency slot frees
+// up.
+func (q *Queue) Enqueue(text, backendName, model, promptTemplate string) *Job {
+	q.mu.Lock()
+	q.nextID++
+	job := &Job{
+		ID:             q.nextID,
+		Text:           text,
+		Backend:        backendName,
+		Model:          model,
+		PromptTemplate: promptTemplate,
+		Status:         StatusQueued,
+		QueuedAt:       time.Now(),
+	}
+	q.jobs = append(q.jobs, job)
+	q.mu.Unlock()
+
+	q.publish()
+	go q.run(job)
+
+	return job
+}
+
+// Cancel stops a queued or running job; it is a no-op for jobs that have
+// already finished.
+func (q *Queue) Cancel(id uint64) {
+	q.mu.Lock()
+	for _, job := range q.jobs {
+		if job.ID != id {
+			continue
+		}
+		if job.cancel != nil {
+			job.cancel()
+		} else if job.Status == StatusQueued {
+			job.Status = StatusCancelled
+			job.EndedAt = time.Now()
+		}
+		break
+	}
+	q.mu.Unlock()
+
+	q.publish()
+}
+
+func (q *Queue) run(job *Job) {
+	q.sem <- struct{}{}
+	defer func() { <-q.sem }()
+
+	q.mu.Lock()
+	if job.Status == StatusCancelled {
+		q.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	job.cancel = cancel
+	job.Status = StatusRunning
+	job.StartedAt = time.Now()
+	q.mu.Unlock()
+	q.publish()
+
+	err := q.translate(ctx, *job, func(chunk string) {
+		q.mu.Lock()
+		job.Result += chunk
+		q.mu.Unlock()
+		q.publish()
+	})
+
+	q.mu.Lock()
+	job.EndedAt = time.Now()
+	switch {
+	case ctx.Err() != nil:
+		job.Status = StatusCancelled
+	case err != nil:
+		job.Status = StatusError
+		job.Err = err
+	default:
+		job.Status = StatusDone
+	}
+	q.mu.Unlock()
+	q.publish()
+}
+
+func (q *Queue) publish() {
+	q.mu.Lock()
+	snapshot := make([]interface{}, len(q.jobs))
+	for i, job := range q.jobs {
+		jobCopy := *job
+		snapshot[i] = &jobCopy
+	}
+	q.mu.Unlock()
+
+	q.Jobs.Set(snapshot)
+}
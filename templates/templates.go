@@ -0,0 +1,73 @@
+// Package templates manages named prompt templates ("agents"): a bundle of
+// a display name and a system prompt that turns lai from a fixed translator
+// into a general text-transformation tool.
+package templates
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Template is a single named prompt. Prompt must contain exactly one %s
+// verb, substituted with the user's input text.
+type Template struct {
+	Name   string `json:"name"`
+	Prompt string `json:"prompt"`
+}
+
+// Defaults ships with the app so there's always something to pick from.
+var Defaults = []Template{
+	{
+		Name:   "Translate to English",
+		Prompt: "Translate the following text to English. If it's already in English, translate it to Spanish. Only provide the translation, no explanations:\n\n%s",
+	},
+	{
+		Name:   "Translate to Spanish",
+		Prompt: "Translate the following text to Spanish. Only provide the translation, no explanations:\n\n%s",
+	},
+	{
+		Name:   "Summarize",
+		Prompt: "Summarize the following text in a few sentences:\n\n%s",
+	},
+	{
+		Name:   "Explain like I'm 5",
+		Prompt: "Explain the following text as if to a five-year-old:\n\n%s",
+	},
+	{
+		Name:   "Fix grammar",
+		Prompt: "Fix the grammar and spelling of the following text, keeping its meaning and tone intact. Only provide the corrected text, no explanations:\n\n%s",
+	},
+}
+
+// Validate reports whether t.Prompt contains exactly the one %s verb it
+// needs to be used with fmt.Sprintf(t.Prompt, input).
+func Validate(t Template) error {
+	if n := strings.Count(t.Prompt, "%s"); n != 1 {
+		return fmt.Errorf("template %q prompt must contain exactly one %%s placeholder, found %d", t.Name, n)
+	}
+	return nil
+}
+
+// Marshal serializes list for storage as a single Fyne preferences string.
+func Marshal(list []Template) (string, error) {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal templates: %w", err)
+	}
+	return string(data), nil
+}
+
+// Unmarshal parses a previously Marshal'd list, returning Defaults if data
+// is empty (i.e. nothing has been persisted yet).
+func Unmarshal(data string) ([]Template, error) {
+	if data == "" {
+		return Defaults, nil
+	}
+
+	var list []Template
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal templates: %w", err)
+	}
+	return list, nil
+}